@@ -6,7 +6,8 @@
 # Kubernetes Secrets expected by the LlamaStack overlays.
 #
 # Prerequisites:
-#   - vault CLI installed and authenticated (vault login / VAULT_TOKEN)
+#   - vault CLI installed and either already authenticated (vault login /
+#     VAULT_TOKEN) or VAULT_AUTH_METHOD set to approle or kubernetes
 #   - kubectl configured for the target cluster
 #
 # Usage:
@@ -23,6 +24,8 @@ set -euo pipefail
 VAULT_KV_MOUNT="${VAULT_KV_MOUNT:-secret}"
 VAULT_PATH_PREFIX="${VAULT_PATH_PREFIX:-llm}"
 NAMESPACE="${NAMESPACE:-llm}"
+VAULT_AUTH_METHOD="${VAULT_AUTH_METHOD:-token}"
+VAULT_AUTH_PATH="${VAULT_AUTH_PATH:-$VAULT_AUTH_METHOD}"
 
 PROVIDERS=(anthropic openai gemini gemini-vertex-ai)
 
@@ -43,10 +46,46 @@ Environment variables:
   VAULT_KV_MOUNT       KV engine mount path (default: secret)
   VAULT_PATH_PREFIX    Path prefix for provider secrets (default: llm)
   NAMESPACE            Kubernetes namespace (default: llm)
+  VAULT_AUTH_METHOD    token, approle or kubernetes (default: token)
+  VAULT_AUTH_PATH      Auth mount path (default: same as VAULT_AUTH_METHOD)
+  VAULT_ROLE_ID        AppRole role ID (approle)
+  VAULT_SECRET_ID      AppRole secret ID (approle)
+  VAULT_ROLE           Vault role bound to the service account (kubernetes)
+  VAULT_K8S_TOKEN_FILE Service account token file (kubernetes,
+                       default: /var/run/secrets/kubernetes.io/serviceaccount/token)
 EOF
   exit 1
 }
 
+vault_login() {
+  case "$VAULT_AUTH_METHOD" in
+    token)
+      # Use the existing token from vault login / VAULT_TOKEN
+      ;;
+    approle)
+      : "${VAULT_ROLE_ID:?VAULT_ROLE_ID must be set for approle auth}"
+      : "${VAULT_SECRET_ID:?VAULT_SECRET_ID must be set for approle auth}"
+      echo "Logging in to Vault with AppRole (auth/${VAULT_AUTH_PATH}) ..."
+      VAULT_TOKEN=$(vault write -field=token "auth/${VAULT_AUTH_PATH}/login" \
+        role_id="$VAULT_ROLE_ID" secret_id="$VAULT_SECRET_ID")
+      export VAULT_TOKEN
+      ;;
+    kubernetes)
+      : "${VAULT_ROLE:?VAULT_ROLE must be set for kubernetes auth}"
+      local token_file="${VAULT_K8S_TOKEN_FILE:-/var/run/secrets/kubernetes.io/serviceaccount/token}"
+      echo "Logging in to Vault with Kubernetes auth (auth/${VAULT_AUTH_PATH}) ..."
+      VAULT_TOKEN=$(vault write -field=token "auth/${VAULT_AUTH_PATH}/login" \
+        role="$VAULT_ROLE" jwt=@"$token_file")
+      export VAULT_TOKEN
+      ;;
+    *)
+      echo "Error: unknown VAULT_AUTH_METHOD '$VAULT_AUTH_METHOD'"
+      echo "Valid methods: token approle kubernetes"
+      exit 1
+      ;;
+  esac
+}
+
 vault_get_field() {
   local path="$1" field="$2"
   vault kv get -mount="$VAULT_KV_MOUNT" -field="$field" "$path"
@@ -141,6 +180,7 @@ fi
 case "$1" in
   --all)
     echo "Syncing all providers from Vault ($VAULT_ADDR) ..."
+    vault_login
     kubectl create namespace "$NAMESPACE" 2>/dev/null || true
     for provider in "${PROVIDERS[@]}"; do
       sync_provider "$provider"
@@ -150,6 +190,7 @@ case "$1" in
   --provider)
     [[ -z "${2:-}" ]] && usage
     echo "Syncing provider '$2' from Vault ($VAULT_ADDR) ..."
+    vault_login
     kubectl create namespace "$NAMESPACE" 2>/dev/null || true
     sync_provider "$2"
     ;;
@@ -9,6 +9,9 @@ PROVIDER=${1:-"gemini"}
 NAMESPACE=${2:-"llm"}
 MODEL=${3:-""}
 
+# LlamaStack route prefixes differ between releases (e.g. /v1/openai/v1/chat/completions)
+CHAT_PATH=${CHAT_PATH:-"/v1/chat/completions"}
+
 echo "🧪 Testing chat completion for provider: $PROVIDER"
 
 # Colors for output
@@ -148,7 +151,7 @@ CHAT_REQUEST='{
 }'
 
 # Construct provider-specific endpoint
-CHAT_ENDPOINT="$MAAS_GATEWAY/llm/${PROVIDER}-llamastack/v1/chat/completions"
+CHAT_ENDPOINT="$MAAS_GATEWAY/llm/${PROVIDER}-llamastack${CHAT_PATH}"
 
 print_info "Sending chat completion request to: $CHAT_ENDPOINT"
 print_info "Request payload:"
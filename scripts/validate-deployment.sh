@@ -8,6 +8,11 @@ set -e
 PROVIDER=${1:-"gemini"}
 NAMESPACE=${2:-"llm"}
 
+# LlamaStack route prefixes differ between releases; override if needed
+HEALTH_PATH=${HEALTH_PATH:-"/v1/health"}
+VERSION_PATH=${VERSION_PATH:-"/v1/version"}
+PROVIDERS_PATH=${PROVIDERS_PATH:-"/v1/providers"}
+
 echo "🔍 Validating Llamastack deployment for provider: $PROVIDER"
 
 # Colors for output
@@ -114,7 +119,7 @@ PORT_FORWARD_PID=$!
 sleep 3
 
 # Test health endpoint
-HEALTH_RESPONSE=$(curl -k -s https://localhost:8443/v1/health 2>/dev/null || echo "FAILED")
+HEALTH_RESPONSE=$(curl -k -s "https://localhost:8443${HEALTH_PATH}" 2>/dev/null || echo "FAILED")
 
 if echo "$HEALTH_RESPONSE" | grep -qi "ok"; then
     print_success "Health endpoint responding correctly"
@@ -127,6 +132,30 @@ else
     exit 1
 fi
 
+# Report the running LlamaStack version (the base image tracks :latest)
+VERSION_RESPONSE=$(curl -k -s "https://localhost:8443${VERSION_PATH}" 2>/dev/null || echo "FAILED")
+LLS_VERSION=$(echo "$VERSION_RESPONSE" | jq -r '.version // empty' 2>/dev/null || echo "")
+
+if [ -n "$LLS_VERSION" ]; then
+    print_success "LlamaStack version: $LLS_VERSION"
+else
+    print_warning "Could not determine LlamaStack version. Response: $VERSION_RESPONSE"
+    print_info "Route layout may differ in this release; see HEALTH_PATH/CHAT_PATH in the README"
+fi
+
+# List the providers wired in behind this instance
+PROVIDERS_RESPONSE=$(curl -k -s "https://localhost:8443${PROVIDERS_PATH}" 2>/dev/null || echo "FAILED")
+PROVIDERS_LIST=$(echo "$PROVIDERS_RESPONSE" | jq -r '.data[] | "\(.api): \(.provider_id) (\(.provider_type))"' 2>/dev/null || echo "")
+
+if [ -n "$PROVIDERS_LIST" ]; then
+    print_success "Configured providers:"
+    echo "$PROVIDERS_LIST" | sort | sed 's/^/    /'
+else
+    print_warning "Could not list providers. Response (first 500 chars):"
+    echo "$PROVIDERS_RESPONSE" | head -c 500
+    echo
+fi
+
 # Clean up port forward
 cleanup_port_forward
 PORT_FORWARD_PID=""